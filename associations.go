@@ -0,0 +1,445 @@
+package fluentmodel
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/jiveio/fluentsql"
+)
+
+// ===========================================================================================================
+//										Associations
+// ===========================================================================================================
+
+// AssociationKind identifies the declarative relationship carried by a `fluent` struct tag.
+type AssociationKind int
+
+const (
+	HasOne AssociationKind = iota
+	HasMany
+	BelongsTo
+	ManyToMany
+)
+
+// Association describes a single relationship parsed off a model field's `fluent` tag, e.g.
+//
+//	Orders []Order `fluent:"hasMany:orders,fk:user_id"`
+//	User   User     `fluent:"belongsTo:user,fk:user_id"`
+//	Roles  []Role   `fluent:"many2many:user_roles,joinFK:user_id,otherFK:role_id"`
+type Association struct {
+	Kind         AssociationKind
+	FieldName    string // Go struct field the association is declared on, e.g. "Orders"
+	FieldType    reflect.Type
+	RelatedTable string // table queried to satisfy the association, e.g. "orders" / "roles"
+	FK           string // hasOne/hasMany/belongsTo foreign key column
+	JoinTable    string // many2many join table, e.g. "user_roles"
+	JoinFK       string // many2many column referencing the owning model's primary key
+	OtherFK      string // many2many column referencing the related model's primary key
+}
+
+// associationsOf scans typ's fields for `fluent` relationship tags.
+func associationsOf(typ reflect.Type) []Association {
+	var out []Association
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		tag := field.Tag.Get("fluent")
+		if tag == "" {
+			continue
+		}
+
+		assoc, ok := parseAssociationTag(tag)
+		if !ok {
+			continue
+		}
+
+		assoc.FieldName = field.Name
+		assoc.FieldType = field.Type
+
+		out = append(out, assoc)
+	}
+
+	return out
+}
+
+// parseAssociationTag parses a `fluent:"..."` tag value into an Association. The first comma
+// separated segment is `kind:relatedTable`; the remaining segments are `key:value` pairs.
+func parseAssociationTag(tag string) (assoc Association, ok bool) {
+	parts := strings.Split(tag, ",")
+	if len(parts) == 0 {
+		return
+	}
+
+	head := strings.SplitN(parts[0], ":", 2)
+	if len(head) != 2 {
+		return
+	}
+
+	switch head[0] {
+	case "hasOne":
+		assoc.Kind = HasOne
+	case "hasMany":
+		assoc.Kind = HasMany
+	case "belongsTo":
+		assoc.Kind = BelongsTo
+	case "many2many":
+		assoc.Kind = ManyToMany
+	default:
+		return
+	}
+
+	assoc.RelatedTable = head[1]
+	if assoc.Kind == ManyToMany {
+		assoc.JoinTable = head[1]
+	}
+
+	for _, part := range parts[1:] {
+		kv := strings.SplitN(part, ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+
+		switch kv[0] {
+		case "fk":
+			assoc.FK = kv[1]
+		case "joinFK":
+			assoc.JoinFK = kv[1]
+		case "otherFK":
+			assoc.OtherFK = kv[1]
+		}
+	}
+
+	ok = true
+
+	return
+}
+
+// PreloadItem is a single requested eager-load, optionally narrowed by a scope callback.
+type PreloadItem struct {
+	Name  string
+	Scope func(q *DBModel) *DBModel
+}
+
+// Preload queues an association to be loaded via a second `SELECT ... WHERE fk IN (...)` query once
+// the primary Find/First completes, with results stitched back onto the parent(s) by reflection.
+// name must match the Go field name the `fluent` tag is declared on (e.g. "Orders").
+//
+//	Example
+//
+// var users []User
+// _, err = db.Preload("Orders", func(q *fluentmodel.DBModel) *fluentmodel.DBModel {
+//
+//	return q.Where("status", fluentsql.Eq, "paid")
+//
+// }).Find(&users)
+func (db *DBModel) Preload(name string, scope ...func(q *DBModel) *DBModel) *DBModel {
+	item := PreloadItem{Name: name}
+
+	if len(scope) > 0 {
+		item.Scope = scope[0]
+	}
+
+	db.preloadStatement.Items = append(db.preloadStatement.Items, item)
+
+	return db
+}
+
+// applyPreloads resolves every queued Preload against the result of a Find/First and stitches the
+// related rows back onto their parent by reflection.
+func (db *DBModel) applyPreloads(table *Table, elemType reflect.Type, model any) error {
+	if len(db.preloadStatement.Items) == 0 {
+		return nil
+	}
+
+	assocByName := associationsByFieldName(elemType)
+	parents := reflect.ValueOf(model).Elem()
+
+	for _, item := range db.preloadStatement.Items {
+		assoc, ok := assocByName[item.Name]
+		if !ok {
+			return fmt.Errorf("fluentmodel: no association %q on %s", item.Name, elemType.Name())
+		}
+
+		if err := db.preloadOne(table, parents, assoc, item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// preloadOne performs the second query for a single Association and assigns its results onto the
+// matching field of every row in parents.
+func (db *DBModel) preloadOne(table *Table, parents reflect.Value, assoc Association, item PreloadItem) error {
+	parentKey := primaryKeyName(table)
+	if parentKey == "" {
+		return fmt.Errorf("fluentmodel: %s has no primary key to preload %q by", table.Name, item.Name)
+	}
+
+	switch assoc.Kind {
+	case HasOne, HasMany:
+		return db.preloadHas(parents, assoc, item, parentKey)
+	case BelongsTo:
+		return db.preloadBelongsTo(parents, assoc, item)
+	case ManyToMany:
+		return db.preloadManyToMany(parents, assoc, item, parentKey)
+	}
+
+	return nil
+}
+
+// preloadHas satisfies hasOne/hasMany: related rows carry a foreign key pointing back at the
+// parent's primary key.
+func (db *DBModel) preloadHas(parents reflect.Value, assoc Association, item PreloadItem, parentKey string) error {
+	parentIds := fieldValues(parents, parentKey)
+
+	related, err := db.queryRelated(assoc.RelatedTable, assoc.FieldType, assoc.FK, parentIds, item.Scope)
+	if err != nil {
+		return err
+	}
+
+	byFK := groupByColumn(related, assoc.FK)
+
+	for i := 0; i < parents.Len(); i++ {
+		parent := parents.Index(i)
+		key := fmt.Sprintf("%v", columnValue(parent, parentKey))
+		matches := byFK[key]
+
+		assignAssociation(parent.FieldByName(assoc.FieldName), assoc.Kind, matches)
+	}
+
+	return nil
+}
+
+// preloadBelongsTo satisfies belongsTo: the parent itself carries the foreign key pointing at the
+// related row's primary key.
+func (db *DBModel) preloadBelongsTo(parents reflect.Value, assoc Association, item PreloadItem) error {
+	fkValues := fieldValues(parents, assoc.FK)
+
+	related, err := db.queryRelated(assoc.RelatedTable, assoc.FieldType, "id", fkValues, item.Scope)
+	if err != nil {
+		return err
+	}
+
+	byPK := groupByColumn(related, "id")
+
+	for i := 0; i < parents.Len(); i++ {
+		parent := parents.Index(i)
+		key := fmt.Sprintf("%v", columnValue(parent, assoc.FK))
+		matches := byPK[key]
+
+		assignAssociation(parent.FieldByName(assoc.FieldName), HasOne, matches)
+	}
+
+	return nil
+}
+
+// preloadManyToMany satisfies many2many: a join table maps parent primary keys to related primary
+// keys, so loading requires a first pass over the join table before fetching the related rows.
+func (db *DBModel) preloadManyToMany(parents reflect.Value, assoc Association, item PreloadItem, parentKey string) error {
+	parentIds := fieldValues(parents, parentKey)
+
+	var pairs []struct {
+		JoinFK  any `db:"join_fk"`
+		OtherFK any `db:"other_fk"`
+	}
+
+	queryBuilder := fluentsql.QueryInstance().
+		Select(fmt.Sprintf("%s AS join_fk", assoc.JoinFK), fmt.Sprintf("%s AS other_fk", assoc.OtherFK)).
+		From(assoc.JoinTable).
+		Where(assoc.JoinFK, fluentsql.In, typedSlice(parentIds))
+
+	if err := db.query(queryBuilder, &pairs); err != nil {
+		return err
+	}
+
+	var otherIds []any
+	otherIdsByParent := map[string][]string{}
+
+	for _, pair := range pairs {
+		parentId := fmt.Sprintf("%v", pair.JoinFK)
+		otherId := fmt.Sprintf("%v", pair.OtherFK)
+
+		otherIdsByParent[parentId] = append(otherIdsByParent[parentId], otherId)
+		otherIds = append(otherIds, pair.OtherFK)
+	}
+
+	related, err := db.queryRelated(assoc.RelatedTable, assoc.FieldType, "id", otherIds, item.Scope)
+	if err != nil {
+		return err
+	}
+
+	byPK := groupByColumn(related, "id")
+
+	for i := 0; i < parents.Len(); i++ {
+		parent := parents.Index(i)
+		key := fmt.Sprintf("%v", columnValue(parent, parentKey))
+
+		var matches []reflect.Value
+		for _, otherId := range otherIdsByParent[key] {
+			matches = append(matches, byPK[otherId]...)
+		}
+
+		assignAssociation(parent.FieldByName(assoc.FieldName), HasMany, matches)
+	}
+
+	return nil
+}
+
+// associationsByFieldName indexes associationsOf by Go field name for Preload lookups.
+func associationsByFieldName(typ reflect.Type) map[string]Association {
+	out := make(map[string]Association)
+	for _, assoc := range associationsOf(typ) {
+		out[assoc.FieldName] = assoc
+	}
+
+	return out
+}
+
+// primaryKeyName returns table's first primary key column name, or "" if it has none.
+func primaryKeyName(table *Table) string {
+	if len(table.Primaries) == 0 {
+		return ""
+	}
+
+	return table.Primaries[0].Name
+}
+
+// queryRelated runs `SELECT * FROM relatedTable WHERE fk IN (ids)`, narrowed by scope if given, into
+// a freshly allocated slice of elemType's element type (unwrapping a slice/pointer field type).
+func (db *DBModel) queryRelated(relatedTable string, fieldType reflect.Type, fk string, ids []any, scope func(q *DBModel) *DBModel) (reflect.Value, error) {
+	elemType := fieldType
+	if elemType.Kind() == reflect.Slice || elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+
+	sliceVal := reflect.New(reflect.SliceOf(elemType))
+
+	if len(ids) == 0 {
+		return sliceVal.Elem(), nil
+	}
+
+	scoped := NewDBModel()
+	if scope != nil {
+		scoped = scope(scoped)
+	}
+
+	queryBuilder := fluentsql.QueryInstance().
+		Select("*").
+		From(relatedTable).
+		Where(fk, fluentsql.In, typedSlice(ids))
+
+	for _, condition := range scoped.whereStatement.Conditions {
+		queryBuilder.Where(condition.Field, condition.Opt, condition.Value)
+	}
+
+	if err := db.query(queryBuilder, sliceVal.Interface()); err != nil {
+		return reflect.Value{}, err
+	}
+
+	return sliceVal.Elem(), nil
+}
+
+// groupByColumn buckets a slice of structs (as returned by queryRelated) by the string form of one
+// of their columns, matching by Go field name via columnValue's naming convention.
+func groupByColumn(rows reflect.Value, column string) map[string][]reflect.Value {
+	out := map[string][]reflect.Value{}
+
+	for i := 0; i < rows.Len(); i++ {
+		row := rows.Index(i)
+		key := fmt.Sprintf("%v", columnValue(row, column))
+		out[key] = append(out[key], row)
+	}
+
+	return out
+}
+
+// fieldValues collects the value of column from every element of parents (a reflect.Value slice),
+// for use in a `WHERE column IN (...)` clause.
+func fieldValues(parents reflect.Value, column string) []any {
+	ids := make([]any, 0, parents.Len())
+
+	for i := 0; i < parents.Len(); i++ {
+		ids = append(ids, columnValue(parents.Index(i), column))
+	}
+
+	return ids
+}
+
+// typedSlice converts a homogeneous []any into a concretely-typed slice (e.g. []int, []string).
+// fluentsql's IN renderer only special-cases concrete slice kinds; handed a []any it falls back to
+// an empty `IN ()`, so every Preload built on fieldValues' []any silently matched nothing. Ids of
+// mixed/unexpected type fall back to the original []any rather than panic.
+func typedSlice(ids []any) any {
+	if len(ids) == 0 || ids[0] == nil {
+		return ids
+	}
+
+	elemType := reflect.TypeOf(ids[0])
+	out := reflect.MakeSlice(reflect.SliceOf(elemType), 0, len(ids))
+
+	for _, id := range ids {
+		if id == nil {
+			return ids
+		}
+
+		v := reflect.ValueOf(id)
+		if v.Type() != elemType {
+			return ids
+		}
+
+		out = reflect.Append(out, v)
+	}
+
+	return out.Interface()
+}
+
+// columnValue looks up a struct field by DB column name, matching case-insensitively and ignoring
+// underscores (e.g. "user_id" matches field "UserId" or "UserID"), the same convention ModelData
+// uses to map columns onto struct fields.
+func columnValue(structVal reflect.Value, column string) any {
+	normalized := strings.ReplaceAll(strings.ToLower(column), "_", "")
+	typ := structVal.Type()
+
+	for i := 0; i < typ.NumField(); i++ {
+		name := strings.ToLower(typ.Field(i).Name)
+		if name == normalized {
+			return structVal.Field(i).Interface()
+		}
+	}
+
+	return nil
+}
+
+// assignAssociation sets field (a parent's association field) to matches, coercing the slice of
+// related rows to whatever shape the field expects: a single struct/pointer for HasOne/BelongsTo,
+// or a slice for HasMany/ManyToMany.
+func assignAssociation(field reflect.Value, kind AssociationKind, matches []reflect.Value) {
+	if !field.IsValid() || !field.CanSet() {
+		return
+	}
+
+	switch kind {
+	case HasOne, BelongsTo:
+		if len(matches) == 0 {
+			return
+		}
+
+		if field.Kind() == reflect.Ptr {
+			ptr := reflect.New(field.Type().Elem())
+			ptr.Elem().Set(matches[0])
+			field.Set(ptr)
+		} else {
+			field.Set(matches[0])
+		}
+	default:
+		slice := reflect.MakeSlice(field.Type(), 0, len(matches))
+		for _, match := range matches {
+			slice = reflect.Append(slice, match)
+		}
+
+		field.Set(slice)
+	}
+}
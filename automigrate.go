@@ -0,0 +1,256 @@
+package fluentmodel
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// ===========================================================================================================
+//										AutoMigrate
+// ===========================================================================================================
+
+// existingColumn describes a column as reported by the database's own catalog, independent of dialect.
+type existingColumn struct {
+	Name     string `db:"column_name"`
+	DataType string `db:"data_type"`
+}
+
+// AutoMigrate inspects each model's Table/Column metadata (the same metadata ModelData produces)
+// against the live database schema and applies the difference: missing tables are created, missing
+// columns are added, columns whose type changed are modified. Columns present in the database but not
+// on the model are only dropped when Migrate().WithDestructive(true) was called; by default they are
+// left untouched.
+//
+//	Example
+//
+// err = db.Migrate().AutoMigrate(&User{}, &Order{})
+//
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (mg *Migrator) AutoMigrate(models ...any) (err error) {
+	for _, model := range models {
+		typ := reflect.TypeOf(model)
+		if typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+
+		val := reflect.New(typ).Elem()
+
+		table := NewTable()
+		table = processModel(typ, val, table)
+
+		if err = mg.autoMigrateTable(table); err != nil {
+			return fmt.Errorf("auto migrate %s: %w", table.Name, err)
+		}
+	}
+
+	return
+}
+
+// autoMigrateTable creates the table if missing, otherwise diffs its columns against what the
+// database's catalog reports and emits the necessary ALTER TABLE statements.
+func (mg *Migrator) autoMigrateTable(table *Table) error {
+	exists, err := mg.tableExists(table.Name)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return mg.db.Exec(mg.createTableSQL(table))
+	}
+
+	existingColumns, err := mg.tableColumns(table.Name)
+	if err != nil {
+		return err
+	}
+
+	existingByName := make(map[string]existingColumn, len(existingColumns))
+	for _, col := range existingColumns {
+		existingByName[col.Name] = col
+	}
+
+	for _, column := range table.Columns {
+		existing, ok := existingByName[column.Name]
+
+		if !ok {
+			if err = mg.db.Exec(fmt.Sprintf(
+				`ALTER TABLE %s ADD COLUMN %s %s`, table.Name, column.Name, mg.columnType(column),
+			)); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if normalizeCatalogType(existing.DataType) != mg.columnKind(column) {
+			if err = mg.db.Exec(mg.alterColumnTypeSQL(table.Name, column)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if mg.destructive {
+		modelColumns := make(map[string]bool, len(table.Columns))
+		for _, column := range table.Columns {
+			modelColumns[column.Name] = true
+		}
+
+		for name := range existingByName {
+			if !modelColumns[name] {
+				if err = mg.db.Exec(fmt.Sprintf(`ALTER TABLE %s DROP COLUMN %s`, table.Name, name)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// tableExists reports whether table exists, querying the dialect-appropriate catalog.
+func (mg *Migrator) tableExists(table string) (exists bool, err error) {
+	var sqlStr string
+
+	// Alias the expression explicitly: Postgres names an unaliased SELECT EXISTS(...) column
+	// "exists", but MySQL names it after the whole expression text, so the scan below would only
+	// ever populate row.Exists on Postgres without this.
+	if dbInstance.DriverName() == "pgx" {
+		sqlStr = `SELECT EXISTS (SELECT 1 FROM pg_catalog.pg_tables WHERE tablename = $1) AS exist_flag`
+	} else {
+		sqlStr = `SELECT EXISTS (SELECT 1 FROM information_schema.tables WHERE table_name = ?) AS exist_flag`
+	}
+
+	var row struct {
+		Exists bool `db:"exist_flag"`
+	}
+
+	if err = mg.db.Raw(sqlStr, table).First(&row); err != nil {
+		return false, err
+	}
+
+	return row.Exists, nil
+}
+
+// tableColumns returns the columns the database currently has for table.
+func (mg *Migrator) tableColumns(table string) (columns []existingColumn, err error) {
+	var sqlStr string
+
+	if dbInstance.DriverName() == "pgx" {
+		sqlStr = `SELECT column_name, data_type FROM information_schema.columns WHERE table_name = $1`
+	} else {
+		sqlStr = `SELECT column_name, data_type FROM information_schema.columns WHERE table_name = ?`
+	}
+
+	_, err = mg.db.Raw(sqlStr, table).Find(&columns)
+
+	return
+}
+
+// createTableSQL renders a CREATE TABLE statement from Table/Column metadata.
+func (mg *Migrator) createTableSQL(table *Table) string {
+	sqlStr := fmt.Sprintf("CREATE TABLE %s (\n", table.Name)
+
+	for i, column := range table.Columns {
+		sqlStr += fmt.Sprintf("\t%s %s", column.Name, mg.columnType(column))
+
+		for _, primary := range table.Primaries {
+			if primary.Name == column.Name {
+				sqlStr += " PRIMARY KEY"
+			}
+		}
+
+		if i < len(table.Columns)-1 {
+			sqlStr += ","
+		}
+
+		sqlStr += "\n"
+	}
+
+	return sqlStr + ")"
+}
+
+// alterColumnTypeSQL renders a dialect-appropriate ALTER COLUMN/MODIFY COLUMN statement.
+func (mg *Migrator) alterColumnTypeSQL(table string, column Column) string {
+	if dbInstance.DriverName() == "pgx" {
+		return fmt.Sprintf(`ALTER TABLE %s ALTER COLUMN %s TYPE %s`, table, column.Name, mg.columnType(column))
+	}
+
+	return fmt.Sprintf(`ALTER TABLE %s MODIFY COLUMN %s %s`, table, column.Name, mg.columnType(column))
+}
+
+// columnKind classifies a Column's Go field type into the portable type categories AutoMigrate
+// understands, so emitted DDL and catalog introspection can be compared on equal footing.
+type columnKind int
+
+const (
+	kindVarchar columnKind = iota
+	kindInt
+	kindBigInt
+	kindFloat
+	kindBool
+	kindTimestamp
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// columnKind classifies column by its Go field type. time.Time is recognized explicitly so
+// CreatedAt/UpdatedAt/DeletedAt lifecycle columns are created as TIMESTAMP rather than VARCHAR.
+func (mg *Migrator) columnKind(column Column) columnKind {
+	if column.FieldType == timeType {
+		return kindTimestamp
+	}
+
+	switch column.FieldType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return kindInt
+	case reflect.Int64:
+		return kindBigInt
+	case reflect.Float32, reflect.Float64:
+		return kindFloat
+	case reflect.Bool:
+		return kindBool
+	default:
+		return kindVarchar
+	}
+}
+
+// columnType renders columnKind as the SQL type AutoMigrate emits in CREATE/ALTER statements.
+func (mg *Migrator) columnType(column Column) string {
+	switch mg.columnKind(column) {
+	case kindInt:
+		return "INT"
+	case kindBigInt:
+		return "BIGINT"
+	case kindFloat:
+		return "DOUBLE PRECISION"
+	case kindBool:
+		return "BOOLEAN"
+	case kindTimestamp:
+		return "TIMESTAMP"
+	default:
+		return "VARCHAR(255)"
+	}
+}
+
+// normalizeCatalogType maps the type name information_schema/pg_catalog reports back onto the same
+// columnKind categories columnType emits, so existing columns compare equal to an unchanged model
+// field instead of being re-ALTERed on every AutoMigrate run.
+func normalizeCatalogType(dataType string) columnKind {
+	switch strings.ToLower(dataType) {
+	case "int", "integer", "int4", "mediumint", "smallint":
+		return kindInt
+	case "bigint", "int8":
+		return kindBigInt
+	case "double precision", "float8", "double", "float", "real":
+		return kindFloat
+	case "boolean", "bool", "tinyint", "tinyint(1)":
+		return kindBool
+	case "timestamp", "timestamp without time zone", "timestamp with time zone", "datetime":
+		return kindTimestamp
+	default:
+		return kindVarchar
+	}
+}
@@ -0,0 +1,125 @@
+package fluentmodel
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/jiveio/fluentsql"
+)
+
+// ===========================================================================================================
+//										FindInBatches
+// ===========================================================================================================
+
+// FindInBatches streams a query's results in pages of batchSize, calling fn once per page, instead
+// of loading the whole result set into memory like Find does. Pages are fetched with keyset
+// pagination (`WHERE pk > lastSeenPK ORDER BY pk ASC LIMIT batchSize`) rather than OFFSET, so each
+// page costs the same regardless of how far into the table it is. Before each call, dest is
+// overwritten (not appended to) with the current page's rows, so fn reads the batch from dest the
+// same way it would read a Find result; FindInBatches never holds more than one page in memory at a
+// time. fn also receives a *DBModel scoped to the same connection/transaction as db, so callers can
+// run an Update for each batch under the same tx. Iteration stops as soon as a page comes back with
+// fewer than batchSize rows.
+//
+//	Example
+//
+// var total int
+// var orders []Order
+// total, err = db.Where("status", fluentsql.Eq, "pending").
+//
+//	FindInBatches(&orders, 500, func(tx *fluentmodel.DBModel, batch int) error {
+//		log.Printf("batch %d has %d rows\n", batch, len(orders))
+//		return nil
+//	})
+//
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (db *DBModel) FindInBatches(dest any, batchSize int, fn func(tx *DBModel, batch int) error) (total int, err error) {
+	typ := reflect.TypeOf(dest)
+
+	if !(typ.Kind() == reflect.Ptr && typ.Elem().Kind() == reflect.Slice) {
+		err = errors.New("invalid data :: dest not *Slice type")
+
+		return
+	}
+
+	elemType := typ.Elem().Elem()
+	elemValue := reflect.New(elemType).Elem()
+
+	table := NewTable()
+	table = processModel(elemType, elemValue, table)
+
+	if len(table.Primaries) == 0 {
+		err = errors.New("fluentmodel: FindInBatches requires a model with a primary key")
+
+		return
+	}
+
+	primaryKey := table.Primaries[0].Name
+
+	destSlice := reflect.ValueOf(dest).Elem()
+
+	var lastSeenPK any
+	batch := 0
+
+	for {
+		pageVal := reflect.New(reflect.SliceOf(elemType))
+
+		queryBuilder := fluentsql.QueryInstance().
+			Select("*").
+			From(table.Name).
+			OrderBy(primaryKey, fluentsql.Asc).
+			Limit(batchSize, 0)
+
+		// Build WHERE condition from a condition list, same as Find/GetOne
+		for _, condition := range db.whereStatement.Conditions {
+			if len(condition.Group) > 0 {
+				queryBuilder.WhereGroup(func(whereBuilder fluentsql.WhereBuilder) *fluentsql.WhereBuilder {
+					whereBuilder.WhereCondition(condition.Group...)
+
+					return &whereBuilder
+				})
+			} else if condition.AndOr == fluentsql.And {
+				queryBuilder.Where(condition.Field, condition.Opt, condition.Value)
+			} else if condition.AndOr == fluentsql.Or {
+				queryBuilder.WhereOr(condition.Field, condition.Opt, condition.Value)
+			}
+		}
+
+		if lastSeenPK != nil {
+			queryBuilder.Where(primaryKey, fluentsql.Greater, lastSeenPK)
+		}
+
+		if err = db.query(queryBuilder, pageVal.Interface()); err != nil {
+			return
+		}
+
+		page := pageVal.Elem()
+		rows := page.Len()
+
+		if rows == 0 {
+			break
+		}
+
+		// Overwrite dest with just this page (rather than accumulating every page) so fn can read
+		// the current batch's rows from dest without FindInBatches holding the whole result in memory.
+		destSlice.Set(page)
+		total += rows
+		batch++
+
+		scoped := &DBModel{tx: db.tx, ctx: db.ctx, log: db.log}
+
+		if err = fn(scoped, batch); err != nil {
+			return
+		}
+
+		lastSeenPK = columnValue(page.Index(rows-1), primaryKey)
+
+		if rows < batchSize {
+			break
+		}
+	}
+
+	return
+}
@@ -0,0 +1,55 @@
+package fluentmodel
+
+import (
+	"context"
+	"time"
+)
+
+// ===========================================================================================================
+//										Context & Query Timeouts
+// ===========================================================================================================
+
+// defaultQueryTimeout bounds every query that does not already carry a deadline via WithContext.
+// Zero means no default timeout is applied.
+var defaultQueryTimeout time.Duration
+
+// SetQueryTimeout sets the process-wide default timeout applied to queries whose context carries no
+// deadline of its own, whether that context came from WithContext or is the background default.
+// Pass 0 to disable the default.
+//
+//	Example
+//
+// db.SetQueryTimeout(200 * time.Millisecond)
+func (db *DBModel) SetQueryTimeout(d time.Duration) *DBModel {
+	defaultQueryTimeout = d
+
+	return db
+}
+
+// WithContext attaches ctx to the builder so the eventual Get/Select/Exec call can be cancelled or
+// time out from the caller's side, e.g. an HTTP request context.
+//
+//	Example
+//
+// var user User
+// err = db.WithContext(r.Context()).First(&user)
+func (db *DBModel) WithContext(ctx context.Context) *DBModel {
+	db.ctx = ctx
+
+	return db
+}
+
+// context returns the builder's context, defaulting to context.Background(), wrapped with
+// defaultQueryTimeout when the caller did not already set a deadline via WithContext.
+func (db *DBModel) context() (context.Context, context.CancelFunc) {
+	ctx := db.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline && defaultQueryTimeout > 0 {
+		return context.WithTimeout(ctx, defaultQueryTimeout)
+	}
+
+	return ctx, func() {}
+}
@@ -0,0 +1,48 @@
+package fluentmodel
+
+import (
+	"github.com/jiveio/fluentsql"
+)
+
+// ===========================================================================================================
+//										Create
+// ===========================================================================================================
+
+// Create inserts model as a new row, stamping CreatedAt/UpdatedAt first for models that declare
+// them (by convention or via `fluent:"autoCreateTime"`/`fluent:"autoUpdateTime"`).
+//
+//	Example
+//
+// user := User{Name: "Cat John"}
+// err = db.Create(&user)
+//
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (db *DBModel) Create(model any) (err error) {
+	StampCreate(model)
+
+	var table *Table
+
+	table, err = ModelData(model)
+	if err != nil {
+		return
+	}
+
+	insertBuilder := fluentsql.InsertInstance().Insert(table.Name)
+
+	for _, column := range table.Columns {
+		if !column.HasValue {
+			continue
+		}
+
+		insertBuilder.Set(column.Name, table.Values[column.Name])
+	}
+
+	err = db.insert(insertBuilder)
+
+	// Reset fluent model builder
+	db.reset()
+
+	return
+}
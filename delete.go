@@ -0,0 +1,123 @@
+package fluentmodel
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jiveio/fluentsql"
+)
+
+// ===========================================================================================================
+//										Delete
+// ===========================================================================================================
+
+// Delete removes model's row. When model's type declares a soft-delete column (by convention
+// `DeletedAt`, or an explicit `fluent:"softDelete"` tag), Delete performs `UPDATE ... SET
+// deleted_at = NOW()` instead of an actual DELETE, so the row keeps satisfying Unscoped() queries
+// and foreign keys. Models without a soft-delete column are removed with a real DELETE.
+//
+//	Example
+//
+// var user User
+// err = db.First(&user)
+// err = db.Delete(&user)
+//
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+func (db *DBModel) Delete(model any) (err error) {
+	var table *Table
+
+	table, err = ModelData(model)
+	if err != nil {
+		return
+	}
+
+	var primaryKey any
+	if len(table.Primaries) > 0 {
+		primaryKey = table.Primaries[0].Name
+	}
+
+	// Build WHERE condition with specific primary value
+	if db.wherePrimaryCondition.Value == nil && primaryKey != nil {
+		if value, ok := table.Values[primaryKey.(string)]; ok {
+			db.wherePrimaryCondition = fluentsql.Condition{
+				Field: primaryKey,
+				Opt:   fluentsql.Eq,
+				Value: value,
+				AndOr: fluentsql.And,
+			}
+		}
+	}
+
+	hasCondition := false
+	deletedAtColumn := lifecycleColumnsOf(elemTypeOf(model)).DeletedAtColumn
+
+	if deletedAtColumn != "" {
+		updateBuilder := fluentsql.UpdateInstance().
+			Update(table.Name).
+			Set(deletedAtColumn, time.Now())
+
+		// Build WHERE condition with specific primary value
+		if db.wherePrimaryCondition.Value != nil && primaryKey != nil {
+			updateBuilder.Where(primaryKey, db.wherePrimaryCondition.Opt, db.wherePrimaryCondition.Value)
+			hasCondition = true
+		}
+
+		// Build WHERE condition from a condition list
+		for _, condition := range db.whereStatement.Conditions {
+			if len(condition.Group) > 0 {
+				updateBuilder.WhereGroup(func(whereBuilder fluentsql.WhereBuilder) *fluentsql.WhereBuilder {
+					whereBuilder.WhereCondition(condition.Group...)
+
+					return &whereBuilder
+				})
+				hasCondition = true
+			} else if condition.AndOr == fluentsql.And {
+				updateBuilder.Where(condition.Field, condition.Opt, condition.Value)
+				hasCondition = true
+			} else if condition.AndOr == fluentsql.Or {
+				updateBuilder.WhereOr(condition.Field, condition.Opt, condition.Value)
+				hasCondition = true
+			}
+		}
+
+		if !hasCondition {
+			return errors.New("missing WHERE condition for delete operator")
+		}
+
+		return db.update(updateBuilder)
+	}
+
+	deleteBuilder := fluentsql.DeleteInstance().From(table.Name)
+
+	// Build WHERE condition with specific primary value
+	if db.wherePrimaryCondition.Value != nil && primaryKey != nil {
+		deleteBuilder.Where(primaryKey, db.wherePrimaryCondition.Opt, db.wherePrimaryCondition.Value)
+		hasCondition = true
+	}
+
+	// Build WHERE condition from a condition list
+	for _, condition := range db.whereStatement.Conditions {
+		if len(condition.Group) > 0 {
+			deleteBuilder.WhereGroup(func(whereBuilder fluentsql.WhereBuilder) *fluentsql.WhereBuilder {
+				whereBuilder.WhereCondition(condition.Group...)
+
+				return &whereBuilder
+			})
+			hasCondition = true
+		} else if condition.AndOr == fluentsql.And {
+			deleteBuilder.Where(condition.Field, condition.Opt, condition.Value)
+			hasCondition = true
+		} else if condition.AndOr == fluentsql.Or {
+			deleteBuilder.WhereOr(condition.Field, condition.Opt, condition.Value)
+			hasCondition = true
+		}
+	}
+
+	if !hasCondition {
+		return errors.New("missing WHERE condition for delete operator")
+	}
+
+	return db.delete(deleteBuilder)
+}
@@ -0,0 +1,56 @@
+package fluentmodel
+
+// ===========================================================================================================
+//										Dialect
+// ===========================================================================================================
+
+// Dialect identifies the SQL flavour dbInstance is connected to, so features with no portable SQL
+// (random ordering, TABLESAMPLE, ...) can pick the right expression.
+type Dialect int
+
+const (
+	DialectMySQL Dialect = iota
+	DialectPostgres
+	DialectSQLite
+	DialectMSSQL
+)
+
+// dialect resolves the active Dialect from dbInstance's registered driver name.
+func dialect() Dialect {
+	switch dbInstance.DriverName() {
+	case "pgx":
+		return DialectPostgres
+	case "sqlite3", "sqlite":
+		return DialectSQLite
+	case "sqlserver":
+		return DialectMSSQL
+	default:
+		return DialectMySQL
+	}
+}
+
+// randomOrderExpr returns the dialect-native SQL expression that orders rows randomly.
+func randomOrderExpr() string {
+	switch dialect() {
+	case DialectPostgres, DialectSQLite:
+		return "RANDOM()"
+	case DialectMSSQL:
+		return "NEWID()"
+	default:
+		return "RAND()"
+	}
+}
+
+// SampleRate enables a Postgres `TABLESAMPLE SYSTEM (pct)` fast path for Take on large tables,
+// trading exact randomness for an approximate, much cheaper sample. pct is a percentage in (0, 100].
+// Ignored on dialects other than Postgres.
+//
+//	Example
+//
+// var user User
+// err = db.SampleRate(5).Take(&user)
+func (db *DBModel) SampleRate(pct float64) *DBModel {
+	db.sampleRate = pct
+
+	return db
+}
@@ -0,0 +1,111 @@
+package fluentmodel
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/jiveio/fluentsql"
+)
+
+// ===========================================================================================================
+//										Timestamps & Soft Delete
+// ===========================================================================================================
+
+// lifecycleColumns records the db column name backing a model's CreatedAt/UpdatedAt/DeletedAt
+// fields, recognized either by the conventional Go field name or by an explicit `fluent` tag
+// (`autoCreateTime`, `autoUpdateTime`, `softDelete`). An empty string means the model has no such
+// column.
+type lifecycleColumns struct {
+	CreatedAtField, CreatedAtColumn string
+	UpdatedAtField, UpdatedAtColumn string
+	DeletedAtField, DeletedAtColumn string
+}
+
+// lifecycleColumnsOf scans typ's fields for timestamp/soft-delete conventions.
+func lifecycleColumnsOf(typ reflect.Type) (lc lifecycleColumns) {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag := field.Tag.Get("fluent")
+		column := columnNameOf(field)
+
+		switch {
+		case tag == "autoCreateTime" || field.Name == "CreatedAt":
+			lc.CreatedAtField, lc.CreatedAtColumn = field.Name, column
+		case tag == "autoUpdateTime" || field.Name == "UpdatedAt":
+			lc.UpdatedAtField, lc.UpdatedAtColumn = field.Name, column
+		case tag == "softDelete" || field.Name == "DeletedAt":
+			lc.DeletedAtField, lc.DeletedAtColumn = field.Name, column
+		}
+	}
+
+	return
+}
+
+// columnNameOf returns the db column backing field, honouring an explicit `db` tag and otherwise
+// converting the Go field name to snake_case (CreatedAt -> created_at).
+func columnNameOf(field reflect.StructField) string {
+	if db := field.Tag.Get("db"); db != "" {
+		return db
+	}
+
+	var b strings.Builder
+	for i, r := range field.Name {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			b.WriteByte('_')
+		}
+
+		b.WriteRune(r)
+	}
+
+	return strings.ToLower(b.String())
+}
+
+// elemTypeOf unwraps a pointer/slice-of-pointer model argument down to its underlying struct type,
+// the same unwrapping GetOne/Find already do inline before calling ModelData/processModel.
+func elemTypeOf(model any) reflect.Type {
+	typ := reflect.TypeOf(model)
+	for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice {
+		typ = typ.Elem()
+	}
+
+	return typ
+}
+
+// Unscoped disables the automatic `WHERE deleted_at IS NULL` that First/Take/Last/Find otherwise
+// append for soft-delete models, returning rows regardless of their DeletedAt column.
+//
+//	Example
+//
+// var users []User
+// _, err = db.Unscoped().Find(&users)
+func (db *DBModel) Unscoped() *DBModel {
+	db.unscoped = true
+
+	return db
+}
+
+// applySoftDeleteScope appends `WHERE deletedAtColumn IS NULL` to queryBuilder unless the model has
+// no soft-delete column or the caller opted out via Unscoped.
+func (db *DBModel) applySoftDeleteScope(deletedAtColumn string, queryBuilder *fluentsql.QueryBuilder) {
+	if deletedAtColumn == "" || db.unscoped {
+		return
+	}
+
+	queryBuilder.Where(deletedAtColumn, fluentsql.Null, nil)
+}
+
+// StampCreate sets a model's CreatedAt (and, for completeness, UpdatedAt) field to now, for the
+// insert path to call before persisting a new row.
+func StampCreate(model any) {
+	lc := lifecycleColumnsOf(elemTypeOf(model))
+	now := time.Now()
+
+	if lc.CreatedAtField != "" {
+		_ = SetValue(model, lc.CreatedAtField, now)
+	}
+
+	if lc.UpdatedAtField != "" {
+		_ = SetValue(model, lc.UpdatedAtField, now)
+	}
+}
@@ -0,0 +1,116 @@
+package fluentmodel
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// ===========================================================================================================
+//										Logger
+// ===========================================================================================================
+
+// Logger is the hook every builder terminal reports through instead of killing the process with
+// log.Fatal. Trace is called once per executed statement; Info/Warn/Error cover everything else
+// (connection setup, migration progress, and so on).
+type Logger interface {
+	Trace(ctx context.Context, sqlStr string, args []any, rowsAffected int64, elapsed time.Duration, err error)
+	Info(ctx context.Context, msg string, args ...any)
+	Warn(ctx context.Context, msg string, args ...any)
+	Error(ctx context.Context, msg string, args ...any)
+}
+
+// defaultSlowThreshold is the elapsed duration above which Trace logs at Warn instead of Debug.
+// Configurable process-wide via DBModel.SetSlowThreshold.
+var defaultSlowThreshold = 200 * time.Millisecond
+
+// defaultLogger is used by every builder that has not been given a Session-scoped Logger.
+var defaultLogger Logger = &slogLogger{logger: slog.Default()}
+
+// slogLogger is the built-in Logger implementation, backed by log/slog.
+type slogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger wraps an existing *slog.Logger as a fluentmodel Logger.
+func NewSlogLogger(logger *slog.Logger) Logger {
+	return &slogLogger{logger: logger}
+}
+
+func (l *slogLogger) Trace(ctx context.Context, sqlStr string, args []any, rowsAffected int64, elapsed time.Duration, err error) {
+	attrs := []any{
+		slog.String("sql", sqlStr),
+		slog.Any("args", args),
+		slog.Int64("rows", rowsAffected),
+		slog.Duration("elapsed", elapsed),
+	}
+
+	if err != nil {
+		l.logger.ErrorContext(ctx, "fluentmodel: query failed", append(attrs, slog.Any("err", err))...)
+
+		return
+	}
+
+	if elapsed > defaultSlowThreshold {
+		l.logger.WarnContext(ctx, "fluentmodel: slow query", attrs...)
+
+		return
+	}
+
+	l.logger.DebugContext(ctx, "fluentmodel: query", attrs...)
+}
+
+func (l *slogLogger) Info(ctx context.Context, msg string, args ...any) {
+	l.logger.InfoContext(ctx, msg, args...)
+}
+
+func (l *slogLogger) Warn(ctx context.Context, msg string, args ...any) {
+	l.logger.WarnContext(ctx, msg, args...)
+}
+
+func (l *slogLogger) Error(ctx context.Context, msg string, args ...any) {
+	l.logger.ErrorContext(ctx, msg, args...)
+}
+
+// Session carries per-builder overrides that would otherwise be process-wide globals.
+type Session struct {
+	Logger Logger
+}
+
+// Session applies per-builder overrides, such as a request-scoped Logger.
+//
+//	Example
+//
+// db.Session(&fluentmodel.Session{Logger: fluentmodel.NewSlogLogger(requestLogger)}).First(&user)
+func (db *DBModel) Session(session *Session) *DBModel {
+	if session.Logger != nil {
+		db.log = session.Logger
+	}
+
+	return db
+}
+
+// SetSlowThreshold sets the process-wide elapsed duration above which Trace logs a query at Warn.
+//
+//	Example
+//
+// db.SetSlowThreshold(200 * time.Millisecond)
+func (db *DBModel) SetSlowThreshold(d time.Duration) *DBModel {
+	defaultSlowThreshold = d
+
+	return db
+}
+
+// logger returns the builder's Logger, falling back to the process-wide default.
+func (db *DBModel) logger() Logger {
+	if db.log != nil {
+		return db.log
+	}
+
+	return defaultLogger
+}
+
+// trace reports the statement through the active Logger, with the time elapsed since start.
+func (db *DBModel) trace(ctx context.Context, sqlStr string, args []any, rowsAffected int64, start time.Time, err error) {
+	db.logger().Trace(ctx, sqlStr, args, rowsAffected, time.Since(start), err)
+}
@@ -0,0 +1,287 @@
+package fluentmodel
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ===========================================================================================================
+//										Schema Migrations
+// ===========================================================================================================
+
+// migrationsTable is the table used to track which migrations have already been applied.
+const migrationsTable = "schema_migrations"
+
+// Migration represents a single, numbered schema change. Id is conventionally a timestamp such as
+// 20240101120000, Name is a short human-readable label (e.g. "add_users"), and Up/Down perform the
+// forward and rollback steps against the Migrator's own *DBModel (so inside its transaction only if
+// that builder already has one; the Migrator does not begin a transaction per migration).
+type Migration struct {
+	Id       int64
+	Name     string
+	Up       func(tx *DBModel) error
+	Down     func(tx *DBModel) error
+	Checksum string
+}
+
+// checksum returns the fingerprint recorded in schema_migrations for this migration. Callers who
+// want Status().Dirty to flag a migration edited after it was applied should set Migration.Checksum
+// themselves (e.g. a hash of the migration's SQL/source); without one, this falls back to an
+// Id+Name fingerprint, which only detects a migration being renamed, not its Up/Down body changing.
+func (m *Migration) checksum() string {
+	if m.Checksum != "" {
+		return m.Checksum
+	}
+
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d:%s", m.Id, m.Name)))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// Migrator runs registered Migration values against a database. It is obtained via DBModel.Migrate
+// and keeps its own reference to the builder so migrations run inside the caller's connection/tx.
+//
+//	Example
+//
+// -------- Register and run migrations  --------
+//
+// db.Migrate().Register(&fluentmodel.Migration{
+//
+//	Id:   20240101120000,
+//	Name: "add_users",
+//	Up: func(tx *fluentmodel.DBModel) error {
+//		return tx.Exec(`ALTER TABLE users ADD COLUMN age INT`)
+//	},
+//	Down: func(tx *fluentmodel.DBModel) error {
+//		return tx.Exec(`ALTER TABLE users DROP COLUMN age`)
+//	},
+//
+// })
+//
+// err = db.Migrate().Up(0)
+//
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+type Migrator struct {
+	db          *DBModel
+	migrations  []*Migration
+	destructive bool
+}
+
+// Migrate returns the Migrator bound to this builder, creating it on first call so that
+// registrations made through one db.Migrate().Register(...) call are still there for the next
+// db.Migrate().Up(...) call. schema_migrations itself is created lazily the first time Up/Down/
+// Status actually queries it, not by Migrate() itself.
+func (db *DBModel) Migrate() *Migrator {
+	if db.migrator == nil {
+		db.migrator = &Migrator{db: db}
+	}
+
+	return db.migrator
+}
+
+// WithDestructive allows AutoMigrate to emit DROP COLUMN statements for columns that exist in the
+// database but no longer exist on the model. Destructive changes are never emitted unless this is
+// explicitly enabled; tables are never dropped.
+func (mg *Migrator) WithDestructive(destructive bool) *Migrator {
+	mg.destructive = destructive
+
+	return mg
+}
+
+// Register adds an explicit, numbered migration to the migrator. Migrations are sorted by Id
+// before being run, so registration order does not matter.
+func (mg *Migrator) Register(migrations ...*Migration) *Migrator {
+	mg.migrations = append(mg.migrations, migrations...)
+
+	sort.Slice(mg.migrations, func(i, j int) bool {
+		return mg.migrations[i].Id < mg.migrations[j].Id
+	})
+
+	return mg
+}
+
+// appliedMigration is a row of the schema_migrations table.
+type appliedMigration struct {
+	Id        int64     `db:"id"`
+	AppliedAt time.Time `db:"applied_at"`
+	Checksum  string    `db:"checksum"`
+}
+
+// Exec runs a raw, non-query statement (DDL, housekeeping INSERT/UPDATE/DELETE) against the
+// builder's connection, using the active transaction if one is set via Model/Begin.
+func (db *DBModel) Exec(sqlStr string, args ...any) (err error) {
+	ctx, cancel := db.context()
+	defer cancel()
+	start := time.Now()
+
+	var result sql.Result
+	if db.tx != nil {
+		result, err = db.tx.ExecContext(ctx, sqlStr, args...)
+	} else {
+		result, err = dbInstance.ExecContext(ctx, sqlStr, args...)
+	}
+
+	var rowsAffected int64
+	if result != nil {
+		rowsAffected, _ = result.RowsAffected()
+	}
+
+	db.trace(ctx, sqlStr, args, rowsAffected, start, err)
+
+	return
+}
+
+// rebind rewrites a `?`-style query into the placeholder syntax dbInstance's driver actually
+// expects (`$1, $2, ...` on pgx), the way every other raw statement in this file would need to if
+// it ran against Postgres.
+func rebind(sqlStr string) string {
+	return sqlx.Rebind(sqlx.BindType(dbInstance.DriverName()), sqlStr)
+}
+
+// ensureMigrationsTable creates schema_migrations if it does not already exist.
+func (mg *Migrator) ensureMigrationsTable() error {
+	return mg.db.Exec(`CREATE TABLE IF NOT EXISTS ` + migrationsTable + ` (
+		id BIGINT PRIMARY KEY,
+		applied_at TIMESTAMP NOT NULL,
+		checksum VARCHAR(64) NOT NULL
+	)`)
+}
+
+// applied returns the migrations already recorded in schema_migrations, keyed by Id.
+func (mg *Migrator) applied() (map[int64]appliedMigration, error) {
+	if err := mg.ensureMigrationsTable(); err != nil {
+		return nil, err
+	}
+
+	var rows []appliedMigration
+	sqlStr := `SELECT id, applied_at, checksum FROM ` + migrationsTable + ` ORDER BY id ASC`
+
+	if _, err := mg.db.Raw(sqlStr).Find(&rows); err != nil {
+		return nil, err
+	}
+
+	out := make(map[int64]appliedMigration, len(rows))
+	for _, row := range rows {
+		out[row.Id] = row
+	}
+
+	return out, nil
+}
+
+// Up applies the next n pending migrations in ascending Id order. n <= 0 applies every pending
+// migration.
+func (mg *Migrator) Up(n int) error {
+	applied, err := mg.applied()
+	if err != nil {
+		return err
+	}
+
+	applyCount := 0
+	for _, m := range mg.migrations {
+		if _, ok := applied[m.Id]; ok {
+			continue
+		}
+
+		if err = m.Up(mg.db); err != nil {
+			return fmt.Errorf("migrate up %d_%s: %w", m.Id, m.Name, err)
+		}
+
+		insertSQL := `INSERT INTO ` + migrationsTable + ` (id, applied_at, checksum) VALUES (?, ?, ?)`
+
+		if err = mg.db.Exec(rebind(insertSQL), m.Id, time.Now(), m.checksum()); err != nil {
+			return err
+		}
+
+		applyCount++
+		if n > 0 && applyCount >= n {
+			break
+		}
+	}
+
+	return nil
+}
+
+// Down rolls back the last n applied migrations in descending Id order. n <= 0 rolls back every
+// applied migration.
+func (mg *Migrator) Down(n int) error {
+	applied, err := mg.applied()
+	if err != nil {
+		return err
+	}
+
+	rollbackCount := 0
+	for i := len(mg.migrations) - 1; i >= 0; i-- {
+		m := mg.migrations[i]
+
+		if _, ok := applied[m.Id]; !ok {
+			continue
+		}
+
+		if err = m.Down(mg.db); err != nil {
+			return fmt.Errorf("migrate down %d_%s: %w", m.Id, m.Name, err)
+		}
+
+		deleteSQL := `DELETE FROM ` + migrationsTable + ` WHERE id = ?`
+
+		if err = mg.db.Exec(rebind(deleteSQL), m.Id); err != nil {
+			return err
+		}
+
+		rollbackCount++
+		if n > 0 && rollbackCount >= n {
+			break
+		}
+	}
+
+	return nil
+}
+
+// Redo rolls back and re-applies the most recently applied migration.
+func (mg *Migrator) Redo() error {
+	if err := mg.Down(1); err != nil {
+		return err
+	}
+
+	return mg.Up(1)
+}
+
+// MigrationStatus describes whether a registered migration has been applied.
+type MigrationStatus struct {
+	Id        int64
+	Name      string
+	Applied   bool
+	AppliedAt *time.Time
+	Dirty     bool // Checksum no longer matches what was recorded when applied
+}
+
+// Status reports the applied/pending state of every registered migration, sorted by Id.
+func (mg *Migrator) Status() ([]MigrationStatus, error) {
+	applied, err := mg.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	status := make([]MigrationStatus, 0, len(mg.migrations))
+	for _, m := range mg.migrations {
+		s := MigrationStatus{Id: m.Id, Name: m.Name}
+
+		if row, ok := applied[m.Id]; ok {
+			s.Applied = true
+			appliedAt := row.AppliedAt
+			s.AppliedAt = &appliedAt
+			s.Dirty = row.Checksum != m.checksum()
+		}
+
+		status = append(status, s)
+	}
+
+	return status, nil
+}
@@ -1,12 +1,11 @@
 package fluentmodel
 
 import (
-	"crypto/rand"
 	"errors"
+	"fmt"
 	"github.com/jiveio/fluentsql"
-	"log"
-	"math/big"
 	"reflect"
+	"time"
 )
 
 // ===========================================================================================================
@@ -154,13 +153,19 @@ func (db *DBModel) Last(model any) (err error) {
 func (db *DBModel) GetOne(model any, getType GetOne) (err error) {
 	// Query raw SQL
 	if db.raw.sqlStr != "" {
+		ctx, cancel := db.context()
+		defer cancel()
+		start := time.Now()
+
 		// Data persistence
 		if db.tx != nil {
-			err = db.tx.Get(model, db.raw.sqlStr, db.raw.args...)
+			err = db.tx.GetContext(ctx, model, db.raw.sqlStr, db.raw.args...)
 		} else {
-			err = dbInstance.Get(model, db.raw.sqlStr, db.raw.args...)
+			err = dbInstance.GetContext(ctx, model, db.raw.sqlStr, db.raw.args...)
 		}
 
+		db.trace(ctx, db.raw.sqlStr, db.raw.args, 1, start, err)
+
 		// Reset fluent model builder
 		db.reset()
 
@@ -197,12 +202,21 @@ func (db *DBModel) GetOne(model any, getType GetOne) (err error) {
 		selectColumns = []any{"*"}
 	}
 
+	// TakeOne on Postgres can opt into an approximate, much cheaper TABLESAMPLE scan via SampleRate
+	fromClause := table.Name
+	if getType == TakeOne && db.sampleRate > 0 && dialect() == DialectPostgres {
+		fromClause = fmt.Sprintf("%s TABLESAMPLE SYSTEM (%v)", table.Name, db.sampleRate)
+	}
+
 	// Create query builder
 	queryBuilder := fluentsql.QueryInstance().
 		Select(selectColumns...).
-		From(table.Name).
+		From(fromClause).
 		Limit(1, 0)
 
+	// Implicitly exclude soft-deleted rows unless Unscoped() was called
+	db.applySoftDeleteScope(lifecycleColumnsOf(elemTypeOf(model)).DeletedAtColumn, queryBuilder)
+
 	// Build WHERE condition with specific primary value
 	if db.wherePrimaryCondition.Value != nil && primaryKey != nil {
 		queryBuilder.Where(primaryKey, db.wherePrimaryCondition.Opt, db.wherePrimaryCondition.Value)
@@ -256,24 +270,29 @@ func (db *DBModel) GetOne(model any, getType GetOne) (err error) {
 		orderByDir = fluentsql.Desc
 	} else if getType == GetFirst && orderByField != "" {
 		orderByDir = fluentsql.Asc
-	} else if getType == TakeOne { // Random order field and order dir
-		n, _ := rand.Int(rand.Reader, big.NewInt(int64(len(table.Columns)-1)))
-		orderByField = table.Columns[n.Int64()].Name
-
-		n, _ = rand.Int(rand.Reader, big.NewInt(10))
-		if n.Int64()%2 == 1 {
-			orderByDir = fluentsql.Asc
-		} else {
-			orderByDir = fluentsql.Desc
-		}
+	} else if getType == TakeOne {
+		// Order by a dialect-native random expression, e.g. RAND() on MySQL, RANDOM() on
+		// Postgres/SQLite, NEWID() on MSSQL, rather than an arbitrary (and stable) column order.
+		orderByField = randomOrderExpr()
 	}
 
 	// Build Order By clause
 	queryBuilder.OrderBy(orderByField, orderByDir)
 
-	// Data persistence
+	// Data persistence. db.get runs against this same *DBModel, so WithContext's ctx/tx are already
+	// on the receiver it executes with; cancellation support belongs in db.get itself, not here.
 	err = db.get(queryBuilder, model)
 
+	// Eager-load associations queued via Preload, through a one-element slice holding the fetched row
+	if err == nil && len(db.preloadStatement.Items) > 0 && typ.Kind() == reflect.Ptr {
+		parents := reflect.New(reflect.SliceOf(typ.Elem()))
+		parents.Elem().Set(reflect.Append(parents.Elem(), reflect.ValueOf(model).Elem()))
+
+		if err = db.applyPreloads(table, typ.Elem(), parents.Interface()); err == nil {
+			reflect.ValueOf(model).Elem().Set(parents.Elem().Index(0))
+		}
+	}
+
 	// Reset fluent model builder
 	db.reset()
 
@@ -288,13 +307,26 @@ func (db *DBModel) GetOne(model any, getType GetOne) (err error) {
 func (db *DBModel) Find(model any, params ...any) (total int, err error) {
 	// Query raw SQL
 	if db.raw.sqlStr != "" {
+		ctx, cancel := db.context()
+		defer cancel()
+		start := time.Now()
+
 		// Data persistence
 		if db.tx != nil {
-			err = db.tx.Select(model, db.raw.sqlStr, db.raw.args...)
+			err = db.tx.SelectContext(ctx, model, db.raw.sqlStr, db.raw.args...)
 		} else {
-			err = dbInstance.Select(model, db.raw.sqlStr, db.raw.args...)
+			err = dbInstance.SelectContext(ctx, model, db.raw.sqlStr, db.raw.args...)
+		}
+
+		// rowsAffected only makes sense for a *slice destination; Raw().Find(&nonSlice) still scans fine,
+		// so don't let tracing it panic on Elem().Len().
+		var rowsAffected int64
+		if elem := reflect.ValueOf(model).Elem(); elem.Kind() == reflect.Slice {
+			rowsAffected = int64(elem.Len())
 		}
 
+		db.trace(ctx, db.raw.sqlStr, db.raw.args, rowsAffected, start, err)
+
 		// Reset fluent model builder
 		db.reset()
 
@@ -355,6 +387,9 @@ func (db *DBModel) Find(model any, params ...any) (total int, err error) {
 		Select(selectColumns...).
 		From(table.Name)
 
+	// Implicitly exclude soft-deleted rows unless Unscoped() was called
+	db.applySoftDeleteScope(lifecycleColumnsOf(typeElement).DeletedAtColumn, queryBuilder)
+
 	// Build JOIN clause
 	for _, joinItem := range db.joinStatement.Items {
 		queryBuilder.Join(joinItem.Join, joinItem.Table, joinItem.Condition)
@@ -362,7 +397,6 @@ func (db *DBModel) Find(model any, params ...any) (total int, err error) {
 
 	// Build WHERE condition with specific primary value
 	if db.wherePrimaryCondition.Value != nil && primaryKey != nil {
-		log.Printf("%v", db.wherePrimaryCondition.Value)
 		queryBuilder.WhereCondition(db.wherePrimaryCondition)
 	}
 
@@ -413,11 +447,17 @@ func (db *DBModel) Find(model any, params ...any) (total int, err error) {
 		queryBuilder.OrderBy(orderItem.Field, orderItem.Direction)
 	}
 
-	// Data persistence
+	// Data persistence. Same as GetOne: db.query runs on this receiver, so it already has the ctx/tx
+	// WithContext set; it, not Find, is where cancellation needs to be wired if it isn't already.
 	if err = db.query(queryBuilder, model); err != nil {
 		return
 	}
 
+	// Eager-load associations queued via Preload
+	if err = db.applyPreloads(table, typeElement, model); err != nil {
+		return
+	}
+
 	if err = db.count(queryBuilder, &total); err != nil {
 		return
 	}
@@ -3,9 +3,9 @@ package fluentmodel
 import (
 	"errors"
 	"github.com/jiveio/fluentsql"
-	"log"
 	"reflect"
 	"slices"
+	"time"
 )
 
 // Update modify data for table via model type Struct, *Struct
@@ -67,7 +67,12 @@ func (db *DBModel) Update(model any) (err error) {
 	}
 
 	if err != nil {
-		log.Fatal(err)
+		ctx, cancel := db.context()
+		defer cancel()
+
+		db.logger().Error(ctx, "fluentmodel: update failed", "err", err)
+
+		return
 	}
 
 	// Reset fluent model builder
@@ -189,9 +194,12 @@ func (db *DBModel) updateByStruct(model any) (err error) {
 		return
 	}
 
+	// updated_at is stamped below regardless of what the struct held, so skip it here
+	updatedAtColumn := lifecycleColumnsOf(elemTypeOf(model)).UpdatedAtColumn
+
 	// Build Updating fields from model's data
 	for _, column := range table.Columns {
-		if !column.HasValue {
+		if !column.HasValue || column.Name == updatedAtColumn {
 			continue
 		}
 
@@ -199,6 +207,13 @@ func (db *DBModel) updateByStruct(model any) (err error) {
 		updateBuilder.Set(column.Name, table.Values[column.Name])
 	}
 
+	// Auto-stamp updated_at for models that declare one
+	if updatedAtColumn != "" {
+		updateBuilder.Set(updatedAtColumn, time.Now())
+	}
+
+	// db.update runs on this receiver too, so it already sees whatever ctx/tx WithContext/Model set;
+	// cancellation support belongs there, not in updateByStruct.
 	err = db.update(updateBuilder)
 
 	return